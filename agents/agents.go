@@ -0,0 +1,217 @@
+// Package agents 提供一个基于 Eino react.Agent 的多 Agent 协调器，
+// 用于替代 demo 中"假并行"（time.Sleep 模拟耗时）的实现。
+package agents
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/flow/agent/react"
+	"github.com/cloudwego/eino/schema"
+	"golang.org/x/sync/errgroup"
+)
+
+// SubAgent 描述一个专业领域的子 Agent。Tools 返回的工具会被原样塞进该子 Agent
+// react.AgentConfig 的 ToolsConfig.Tools，没有工具时返回 nil 即可。
+type SubAgent interface {
+	Name() string
+	Role() string
+	SystemPrompt() string
+	Tools() []tool.BaseTool
+}
+
+// AgentReport 是子 Agent 在执行过程中上报的状态事件。
+type AgentReport struct {
+	AgentName string    `json:"agent_name"`
+	Task      string    `json:"task"`
+	Status    string    `json:"status"` // "in_progress", "completed", "failed"
+	Result    string    `json:"result"`
+	Duration  float64   `json:"duration_ms"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TaskResult 汇总了一次并行任务分发的最终结果。
+type TaskResult struct {
+	Task    string        `json:"task"`
+	Status  string        `json:"status"`
+	Reports []AgentReport `json:"reports"`
+	Summary string        `json:"summary"`
+}
+
+// Task 是分配给某个子 Agent 的一条具体工作。
+type Task struct {
+	Name        string
+	AgentType   string
+	Description string
+}
+
+// Coordinator 负责为每个 Task 实例化对应的 react.Agent，
+// 并通过 errgroup 并行执行，带超时和取消控制。
+type Coordinator struct {
+	mu       sync.RWMutex
+	configs  map[string]*react.AgentConfig
+	timeout  time.Duration
+	onReport func(AgentReport)
+}
+
+// NewCoordinator 创建一个 Coordinator，默认每个子 Agent 的执行超时为 timeout。
+// timeout <= 0 时不做超时限制。
+func NewCoordinator(timeout time.Duration) *Coordinator {
+	return &Coordinator{
+		configs: make(map[string]*react.AgentConfig),
+		timeout: timeout,
+	}
+}
+
+// WithSubAgent 注册一个子 Agent 的配置，name 对应 Task.AgentType。
+// 用户可以传入自定义的 react.AgentConfig，使用不同的模型或工具集。
+func (c *Coordinator) WithSubAgent(name string, cfg *react.AgentConfig) *Coordinator {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configs[name] = cfg
+	return c
+}
+
+// OnReport 注册一个回调，每当某个子 Agent 产生一条 AgentReport 时被调用。
+// 用于把中间过程事件流式地暴露给调用方（例如写入 channel 或打印进度）。
+func (c *Coordinator) OnReport(fn func(AgentReport)) *Coordinator {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onReport = fn
+	return c
+}
+
+// Reports 返回一个只读 channel，会收到 Run 过程中产生的所有 AgentReport。
+// Run 不会关闭这个 channel：第二个返回值是关闭函数，由调用方在 Run 返回后调用。
+func (c *Coordinator) Reports(ctx context.Context) (<-chan AgentReport, func()) {
+	ch := make(chan AgentReport, 16)
+
+	c.mu.Lock()
+	prev := c.onReport
+	c.onReport = func(r AgentReport) {
+		if prev != nil {
+			prev(r)
+		}
+		select {
+		case ch <- r:
+		case <-ctx.Done():
+		}
+	}
+	c.mu.Unlock()
+
+	return ch, func() { close(ch) }
+}
+
+func (c *Coordinator) report(r AgentReport) {
+	c.mu.RLock()
+	fn := c.onReport
+	c.mu.RUnlock()
+	if fn != nil {
+		fn(r)
+	}
+}
+
+// Run 为 tasks 中的每一项任务实例化对应的 react.Agent 并发执行，
+// 单个子 Agent 失败或超时不会中断其它子 Agent，最终结果汇总进 TaskResult。
+func (c *Coordinator) Run(ctx context.Context, tasks []Task) (*TaskResult, error) {
+	c.mu.RLock()
+	configs := make(map[string]*react.AgentConfig, len(c.configs))
+	for k, v := range c.configs {
+		configs[k] = v
+	}
+	c.mu.RUnlock()
+
+	reports := make([]AgentReport, len(tasks))
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	for i, task := range tasks {
+		i, task := i, task
+		eg.Go(func() error {
+			start := time.Now()
+			c.report(AgentReport{
+				AgentName: task.AgentType,
+				Task:      task.Name,
+				Status:    "in_progress",
+				Timestamp: start,
+			})
+
+			cfg, ok := configs[task.AgentType]
+			if !ok {
+				reports[i] = AgentReport{
+					AgentName: task.AgentType,
+					Task:      task.Name,
+					Status:    "failed",
+					Result:    fmt.Sprintf("未注册的 agent_type: %s", task.AgentType),
+					Duration:  float64(time.Since(start).Milliseconds()),
+					Timestamp: time.Now(),
+				}
+				c.report(reports[i])
+				return nil
+			}
+
+			taskCtx := egCtx
+			cancel := func() {}
+			if c.timeout > 0 {
+				taskCtx, cancel = context.WithTimeout(egCtx, c.timeout)
+			}
+			defer cancel()
+
+			agent, err := react.NewAgent(taskCtx, cfg)
+			if err != nil {
+				reports[i] = AgentReport{
+					AgentName: task.AgentType,
+					Task:      task.Name,
+					Status:    "failed",
+					Result:    fmt.Sprintf("创建 agent 失败: %v", err),
+					Duration:  float64(time.Since(start).Milliseconds()),
+					Timestamp: time.Now(),
+				}
+				c.report(reports[i])
+				return nil
+			}
+
+			resp, err := agent.Generate(taskCtx, []*schema.Message{
+				schema.UserMessage(task.Description),
+			})
+			status, result := "completed", ""
+			if err != nil {
+				status = "failed"
+				result = fmt.Sprintf("执行失败: %v", err)
+			} else {
+				result = resp.Content
+			}
+
+			reports[i] = AgentReport{
+				AgentName: task.AgentType,
+				Task:      task.Name,
+				Status:    status,
+				Result:    result,
+				Duration:  float64(time.Since(start).Milliseconds()),
+				Timestamp: time.Now(),
+			}
+			c.report(reports[i])
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	succeeded := 0
+	for _, r := range reports {
+		if r.Status == "completed" {
+			succeeded++
+		}
+	}
+
+	return &TaskResult{
+		Task:    "并行开发任务",
+		Status:  "completed",
+		Reports: reports,
+		Summary: fmt.Sprintf("并行任务完成！共 %d 个任务，%d 个成功", len(tasks), succeeded),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package agents
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/flow/agent/react"
+	"github.com/cloudwego/eino/schema"
+)
+
+// basicSubAgent 是 SubAgent 的一个简单实现，足以描述 demo 中内置的五个专业角色。
+type basicSubAgent struct {
+	name         string
+	role         string
+	systemPrompt string
+	tools        []tool.BaseTool
+}
+
+func (a *basicSubAgent) Name() string           { return a.name }
+func (a *basicSubAgent) Role() string           { return a.role }
+func (a *basicSubAgent) SystemPrompt() string   { return a.systemPrompt }
+func (a *basicSubAgent) Tools() []tool.BaseTool { return a.tools }
+
+// DefaultSubAgents 是内置的五个专业角色：架构师、后端、前端、测试、运维。
+func DefaultSubAgents() []SubAgent {
+	return []SubAgent{
+		&basicSubAgent{
+			name:         "architect",
+			role:         "系统架构师",
+			systemPrompt: "你是一名系统架构师，负责设计系统的整体结构、模块划分和技术选型。回答需简洁、可执行。",
+		},
+		&basicSubAgent{
+			name:         "backend_dev",
+			role:         "后端开发工程师",
+			systemPrompt: "你是一名后端开发工程师，负责实现服务端 API 与业务逻辑。给出具体的实现思路或代码片段。",
+		},
+		&basicSubAgent{
+			name:         "frontend_dev",
+			role:         "前端开发工程师",
+			systemPrompt: "你是一名前端开发工程师，负责实现页面与交互逻辑。给出具体的实现思路或代码片段。",
+		},
+		&basicSubAgent{
+			name:         "test_dev",
+			role:         "测试开发工程师",
+			systemPrompt: "你是一名测试开发工程师，负责为给定需求编写测试用例并指出潜在的边界情况。",
+		},
+		&basicSubAgent{
+			name:         "devops",
+			role:         "运维工程师",
+			systemPrompt: "你是一名运维工程师，负责部署方案与运行时配置。给出具体的部署步骤或配置示例。",
+		},
+	}
+}
+
+// NewCoordinatorWithDefaults 创建一个 Coordinator，并为 DefaultSubAgents 中的每个角色
+// 注册一个使用同一个 chatModel 的 react.AgentConfig。调用方仍可以用 WithSubAgent
+// 覆盖其中任意角色，或追加自定义角色。
+func NewCoordinatorWithDefaults(ctx context.Context, chatModel model.ToolCallingChatModel, timeout time.Duration) *Coordinator {
+	c := NewCoordinator(timeout)
+	for _, sa := range DefaultSubAgents() {
+		sa := sa
+		c.WithSubAgent(sa.Name(), &react.AgentConfig{
+			ToolCallingModel: chatModel,
+			ToolsConfig:      compose.ToolsNodeConfig{Tools: sa.Tools()},
+			MaxStep:          10,
+			MessageModifier: func(ctx context.Context, input []*schema.Message) []*schema.Message {
+				res := make([]*schema.Message, 0, len(input)+1)
+				res = append(res, schema.SystemMessage(sa.SystemPrompt()))
+				res = append(res, input...)
+				return res
+			},
+		})
+	}
+	return c
+}
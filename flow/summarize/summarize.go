@@ -0,0 +1,196 @@
+// Package summarize 实现群聊摘要的 map-reduce 流程：从 RecordSource 拉取聊天
+// 记录，按模型上下文窗口分块，先对每个分块做摘要，再把分块摘要合并成最终摘要。
+// 参考的是群助手里"抓取聊天记录 -> 喂给 LLM -> 返回格式化摘要"的常见做法。
+package summarize
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// Record 是一条原始聊天记录。
+type Record struct {
+	Sender    string
+	Content   string
+	Timestamp time.Time
+}
+
+// RecordSource 负责从某个数据源（群聊数据库、IM 导出文件等）拉取记录。
+type RecordSource interface {
+	FetchRecords(ctx context.Context, groupID string, since, until time.Time) ([]Record, error)
+}
+
+// Topic 是摘要中识别出的一个话题。
+type Topic struct {
+	Name     string `json:"name"`
+	Mentions int    `json:"mentions"`
+}
+
+// Summary 是一次摘要的结构化结果。
+type Summary struct {
+	Highlights   []string       `json:"highlights"`
+	Topics       []Topic        `json:"topics"`
+	Participants map[string]int `json:"participants"`
+	Markdown     string         `json:"markdown"`
+}
+
+// Formatter 把 Summary 渲染成最终展示用的文本（默认是 Markdown，可替换为纯文本等）。
+type Formatter func(s Summary) string
+
+// Config 配置一个 ChatSummarizer。
+type Config struct {
+	ChatModel model.ToolCallingChatModel
+	Source    RecordSource
+
+	// MinRecords 是触发摘要所需的最少记录数，低于这个数量认为"自己看一眼就好"，
+	// 直接返回错误而不必浪费一次模型调用。默认 10。
+	MinRecords int
+	// MaxChunkChars 是单个分块允许的最大字符数，用于把记录切到能塞进模型上下文
+	// 窗口的大小。默认 4000。单条记录本身超过该值时独占一个分块，不会被切开。
+	MaxChunkChars int
+
+	Formatter Formatter
+}
+
+// ChatSummarizer 对群聊记录做 map-reduce 风格的摘要。
+type ChatSummarizer struct {
+	cfg Config
+}
+
+// NewChatSummarizer 创建一个 ChatSummarizer，未设置的字段会被填充为默认值。
+func NewChatSummarizer(cfg Config) *ChatSummarizer {
+	if cfg.MinRecords <= 0 {
+		cfg.MinRecords = 10
+	}
+	if cfg.MaxChunkChars <= 0 {
+		cfg.MaxChunkChars = 4000
+	}
+	if cfg.Formatter == nil {
+		cfg.Formatter = defaultFormatter
+	}
+	return &ChatSummarizer{cfg: cfg}
+}
+
+// Summarize 拉取 [since, until) 区间内 groupID 的聊天记录并生成摘要。
+// 记录数少于 MinRecords 时返回错误，提示调用方自己翻一下聊天记录即可，不值得调用模型。
+func (s *ChatSummarizer) Summarize(ctx context.Context, groupID string, since, until time.Time) (*Summary, error) {
+	records, err := s.cfg.Source.FetchRecords(ctx, groupID, since, until)
+	if err != nil {
+		return nil, fmt.Errorf("summarize: fetch records: %w", err)
+	}
+	if len(records) < s.cfg.MinRecords {
+		return nil, fmt.Errorf("summarize: only %d records (< %d), look yourself", len(records), s.cfg.MinRecords)
+	}
+
+	chunks := chunkRecords(records, s.cfg.MaxChunkChars)
+
+	partials := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		partial, err := s.summarizeChunk(ctx, chunk)
+		if err != nil {
+			return nil, fmt.Errorf("summarize: chunk %d/%d: %w", i+1, len(chunks), err)
+		}
+		partials = append(partials, partial)
+	}
+
+	summary, err := s.mergeSummaries(ctx, partials)
+	if err != nil {
+		return nil, fmt.Errorf("summarize: merge: %w", err)
+	}
+
+	summary.Participants = countParticipants(records)
+	summary.Markdown = s.cfg.Formatter(*summary)
+	return summary, nil
+}
+
+func (s *ChatSummarizer) summarizeChunk(ctx context.Context, records []Record) (string, error) {
+	var b strings.Builder
+	for _, r := range records {
+		fmt.Fprintf(&b, "[%s] %s: %s\n", r.Timestamp.Format("15:04"), r.Sender, r.Content)
+	}
+
+	resp, err := s.cfg.ChatModel.Generate(ctx, []*schema.Message{
+		schema.SystemMessage("你是群聊摘要助手，请用简洁的要点总结下面这段聊天记录的主要内容，不要遗漏关键信息。"),
+		schema.UserMessage(b.String()),
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Content, nil
+}
+
+func (s *ChatSummarizer) mergeSummaries(ctx context.Context, partials []string) (*Summary, error) {
+	merged := strings.Join(partials, "\n---\n")
+
+	resp, err := s.cfg.ChatModel.Generate(ctx, []*schema.Message{
+		schema.SystemMessage(`你是群聊摘要助手，请把下面多段分块摘要合并成一份最终摘要，并以如下 JSON 格式输出（不要包含多余文字）：
+{"highlights": ["要点1", "要点2"], "topics": [{"name": "话题名", "mentions": 次数}]}`),
+		schema.UserMessage(merged),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var summary Summary
+	if err := json.Unmarshal([]byte(resp.Content), &summary); err != nil {
+		return nil, fmt.Errorf("parse merged summary: %w", err)
+	}
+	return &summary, nil
+}
+
+func chunkRecords(records []Record, maxChunkChars int) [][]Record {
+	var chunks [][]Record
+	var current []Record
+	size := 0
+
+	for _, r := range records {
+		recordSize := utf8.RuneCountInString(r.Sender) + utf8.RuneCountInString(r.Content) + 1
+		if size+recordSize > maxChunkChars && len(current) > 0 {
+			chunks = append(chunks, current)
+			current = nil
+			size = 0
+		}
+		current = append(current, r)
+		size += recordSize
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+func countParticipants(records []Record) map[string]int {
+	counts := make(map[string]int)
+	for _, r := range records {
+		counts[r.Sender]++
+	}
+	return counts
+}
+
+func defaultFormatter(s Summary) string {
+	var b strings.Builder
+	b.WriteString("## 群聊摘要\n\n### 要点\n")
+	for _, h := range s.Highlights {
+		fmt.Fprintf(&b, "- %s\n", h)
+	}
+	if len(s.Topics) > 0 {
+		b.WriteString("\n### 话题\n")
+		for _, t := range s.Topics {
+			fmt.Fprintf(&b, "- %s（提及 %d 次）\n", t.Name, t.Mentions)
+		}
+	}
+	if len(s.Participants) > 0 {
+		b.WriteString("\n### 参与者发言数\n")
+		for name, count := range s.Participants {
+			fmt.Fprintf(&b, "- %s: %d 条\n", name, count)
+		}
+	}
+	return b.String()
+}
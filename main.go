@@ -7,25 +7,16 @@ import (
 
 	"github.com/cloudwego/eino/components/prompt"
 	"github.com/cloudwego/eino/schema"
-	"github.com/cloudwego/eino-ext/components/model/openai"
+
+	"github.com/MeloGum/eino-minimax-demo/model/registry"
 )
 
 func main() {
 	ctx := context.Background()
 
-	// MiniMax API 配置
-	apiKey := os.Getenv("MINIMAX_API_KEY")
-	if apiKey == "" {
-		fmt.Println("Error: MINIMAX_API_KEY not set")
-		os.Exit(1)
-	}
-
-	// 创建 ChatModel (使用 OpenAI 客户端 + MiniMax 端点)
-	chatModel, err := openai.NewChatModel(ctx, &openai.ChatModelConfig{
-		Model:   "MiniMax-M2.1",
-		APIKey:  apiKey,
-		BaseURL: "https://api.minimaxi.com/v1",
-	})
+	// 创建 ChatModel：provider/model/API key 环境变量都来自 model.yaml，
+	// 换成 moonshot/skylark/gemini 只需要改配置文件，不用改代码。
+	chatModel, err := registry.NewFromConfig(ctx, "model.yaml")
 	if err != nil {
 		fmt.Printf("Failed to create chat model: %v\n", err)
 		os.Exit(1)
@@ -0,0 +1,52 @@
+package registry
+
+// FinishReason 是跨 provider 统一的结束原因枚举。各 provider 的原生 finish
+// reason 字符串（OpenAI 兼容的 "stop"/"tool_calls"、Skylark 的 "normal"/"sensitive"、
+// Gemini 的 "STOP"/"SAFETY" 等）都会被归一化成这几个值。
+type FinishReason string
+
+const (
+	FinishReasonStop          FinishReason = "stop"
+	FinishReasonToolCalls     FinishReason = "tool_calls"
+	FinishReasonLength        FinishReason = "length"
+	FinishReasonContentFilter FinishReason = "content_filter"
+	FinishReasonUnknown       FinishReason = "unknown"
+)
+
+// NormalizeFinishReason 把 provider 返回的原生 finish reason 映射到 FinishReason。
+func NormalizeFinishReason(provider, native string) FinishReason {
+	switch provider {
+	case "minimax", "openai", "moonshot":
+		switch native {
+		case "stop":
+			return FinishReasonStop
+		case "tool_calls":
+			return FinishReasonToolCalls
+		case "length":
+			return FinishReasonLength
+		case "content_filter":
+			return FinishReasonContentFilter
+		}
+	case "skylark":
+		switch native {
+		case "normal":
+			return FinishReasonStop
+		case "function_call":
+			return FinishReasonToolCalls
+		case "length":
+			return FinishReasonLength
+		case "sensitive":
+			return FinishReasonContentFilter
+		}
+	case "gemini":
+		switch native {
+		case "STOP":
+			return FinishReasonStop
+		case "MAX_TOKENS":
+			return FinishReasonLength
+		case "SAFETY", "RECITATION":
+			return FinishReasonContentFilter
+		}
+	}
+	return FinishReasonUnknown
+}
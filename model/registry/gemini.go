@@ -0,0 +1,231 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/google/generative-ai-go/genai"
+	"google.golang.org/api/option"
+)
+
+// geminiChatModel 适配 Google generative-ai-go SDK，把它包装成 eino 的
+// model.ToolCallingChatModel，finish reason 通过 NormalizeFinishReason("gemini", ...)
+// 归一化到 ResponseMeta。
+type geminiChatModel struct {
+	client *genai.Client
+	model  string
+	tools  []*schema.ToolInfo
+}
+
+func newGeminiChatModel(ctx context.Context, modelName, apiKey string) (model.ToolCallingChatModel, error) {
+	if modelName == "" {
+		return nil, fmt.Errorf("gemini: model is required")
+	}
+	client, err := genai.NewClient(ctx, option.WithAPIKey(apiKey))
+	if err != nil {
+		return nil, fmt.Errorf("gemini: new client: %w", err)
+	}
+	return &geminiChatModel{client: client, model: modelName}, nil
+}
+
+func (m *geminiChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return &geminiChatModel{client: m.client, model: m.model, tools: tools}, nil
+}
+
+func (m *geminiChatModel) Generate(ctx context.Context, messages []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	gm := m.client.GenerativeModel(m.model)
+	tools, err := geminiTools(m.tools)
+	if err != nil {
+		return nil, fmt.Errorf("gemini: %w", err)
+	}
+	gm.Tools = tools
+	resp, err := gm.GenerateContent(ctx, geminiParts(messages)...)
+	if err != nil {
+		return nil, fmt.Errorf("gemini: generate content: %w", err)
+	}
+	return geminiToMessage(resp), nil
+}
+
+func (m *geminiChatModel) Stream(ctx context.Context, messages []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	gm := m.client.GenerativeModel(m.model)
+	tools, err := geminiTools(m.tools)
+	if err != nil {
+		return nil, fmt.Errorf("gemini: %w", err)
+	}
+	gm.Tools = tools
+	iter := gm.GenerateContentStream(ctx, geminiParts(messages)...)
+
+	sr, sw := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer sw.Close()
+		for {
+			chunk, err := iter.Next()
+			if err != nil {
+				return
+			}
+			sw.Send(geminiToMessage(chunk), nil)
+		}
+	}()
+	return sr, nil
+}
+
+// geminiTools 把 WithTools 收到的 schema.ToolInfo 列表转成 genai 的 Tool，没有
+// 工具时返回 nil，这样 gm.Tools 保持 generative-ai-go 的零值默认行为。
+func geminiTools(tools []*schema.ToolInfo) ([]*genai.Tool, error) {
+	if len(tools) == 0 {
+		return nil, nil
+	}
+
+	decls := make([]*genai.FunctionDeclaration, 0, len(tools))
+	for _, t := range tools {
+		params, err := toolJSONSchema(t)
+		if err != nil {
+			return nil, err
+		}
+		sc, err := jsonSchemaToGenai(params)
+		if err != nil {
+			return nil, fmt.Errorf("tool %s: %w", t.Name, err)
+		}
+		decls = append(decls, &genai.FunctionDeclaration{
+			Name:        t.Name,
+			Description: t.Desc,
+			Parameters:  sc,
+		})
+	}
+	return []*genai.Tool{{FunctionDeclarations: decls}}, nil
+}
+
+// jsonSchemaToGenai 把 toolJSONSchema 产出的通用 JSON Schema 递归转成
+// genai.Schema，generative-ai-go 的 FunctionDeclaration.Parameters 要的就是这个
+// 类型而不是裸 map。
+func jsonSchemaToGenai(m map[string]any) (*genai.Schema, error) {
+	sc := &genai.Schema{}
+
+	typeStr, _ := m["type"].(string)
+	switch typeStr {
+	case "object":
+		sc.Type = genai.TypeObject
+	case "array":
+		sc.Type = genai.TypeArray
+	case "string":
+		sc.Type = genai.TypeString
+	case "integer":
+		sc.Type = genai.TypeInteger
+	case "number":
+		sc.Type = genai.TypeNumber
+	case "boolean":
+		sc.Type = genai.TypeBoolean
+	default:
+		sc.Type = genai.TypeObject
+	}
+
+	if desc, ok := m["description"].(string); ok {
+		sc.Description = desc
+	}
+
+	if enum, ok := m["enum"].([]any); ok {
+		for _, e := range enum {
+			if s, ok := e.(string); ok {
+				sc.Enum = append(sc.Enum, s)
+			}
+		}
+	}
+
+	if required, ok := m["required"].([]any); ok {
+		for _, r := range required {
+			if s, ok := r.(string); ok {
+				sc.Required = append(sc.Required, s)
+			}
+		}
+	}
+
+	if props, ok := m["properties"].(map[string]any); ok {
+		sc.Properties = make(map[string]*genai.Schema, len(props))
+		for name, raw := range props {
+			prop, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			propSchema, err := jsonSchemaToGenai(prop)
+			if err != nil {
+				return nil, err
+			}
+			sc.Properties[name] = propSchema
+		}
+	}
+
+	if items, ok := m["items"].(map[string]any); ok {
+		itemSchema, err := jsonSchemaToGenai(items)
+		if err != nil {
+			return nil, err
+		}
+		sc.Items = itemSchema
+	}
+
+	return sc, nil
+}
+
+func geminiParts(messages []*schema.Message) []genai.Part {
+	parts := make([]genai.Part, 0, len(messages))
+	for _, msg := range messages {
+		parts = append(parts, genai.Text(msg.Content))
+	}
+	return parts
+}
+
+func geminiToMessage(resp *genai.GenerateContentResponse) *schema.Message {
+	var content string
+	var finishReason string
+	var toolCalls []schema.ToolCall
+	if len(resp.Candidates) > 0 {
+		cand := resp.Candidates[0]
+		// FinishReason.String() 返回的是 Go 常量名（如 "FinishReasonStop"），
+		// 这里换成 Gemini API 的原生名称再交给 NormalizeFinishReason。
+		switch cand.FinishReason {
+		case genai.FinishReasonStop:
+			finishReason = "STOP"
+		case genai.FinishReasonMaxTokens:
+			finishReason = "MAX_TOKENS"
+		case genai.FinishReasonSafety:
+			finishReason = "SAFETY"
+		case genai.FinishReasonRecitation:
+			finishReason = "RECITATION"
+		}
+		if cand.Content != nil {
+			for _, part := range cand.Content.Parts {
+				switch p := part.(type) {
+				case genai.Text:
+					content += string(p)
+				case genai.FunctionCall:
+					toolCalls = append(toolCalls, geminiFunctionCallToToolCall(len(toolCalls), p))
+				}
+			}
+		}
+	}
+
+	msg := schema.AssistantMessage(content, toolCalls)
+	msg.ResponseMeta = &schema.ResponseMeta{
+		FinishReason: string(NormalizeFinishReason("gemini", finishReason)),
+	}
+	return msg
+}
+
+// geminiFunctionCallToToolCall 把 genai.FunctionCall 转成 eino 的 schema.ToolCall，
+// generative-ai-go 不返回 call id，这里按 parts 里的出现顺序生成一个稳定 id。
+func geminiFunctionCallToToolCall(index int, fc genai.FunctionCall) schema.ToolCall {
+	args, err := json.Marshal(fc.Args)
+	if err != nil {
+		args = []byte("{}")
+	}
+	return schema.ToolCall{
+		ID:   fmt.Sprintf("call_%d", index),
+		Type: "function",
+		Function: schema.FunctionCall{
+			Name:      fc.Name,
+			Arguments: string(args),
+		},
+	}
+}
@@ -0,0 +1,97 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino-ext/components/model/openai"
+)
+
+func init() {
+	Register("minimax", minimaxFactory)
+	Register("openai", openaiFactory)
+	Register("moonshot", moonshotFactory)
+	Register("skylark", skylarkFactory)
+	Register("gemini", geminiFactory)
+}
+
+func apiKey(cfg Config) (string, error) {
+	envName := cfg.APIKeyEnv
+	if envName == "" {
+		return "", fmt.Errorf("api_key_env is required")
+	}
+	key := os.Getenv(envName)
+	if key == "" {
+		return "", fmt.Errorf("environment variable %s is not set", envName)
+	}
+	return key, nil
+}
+
+// minimaxFactory 构造一个指向 MiniMax 的 ChatModel，MiniMax 的 API 兼容 OpenAI。
+func minimaxFactory(ctx context.Context, cfg Config) (model.ToolCallingChatModel, error) {
+	key, err := apiKey(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("minimax: %w", err)
+	}
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.minimaxi.com/v1"
+	}
+	return openai.NewChatModel(ctx, &openai.ChatModelConfig{
+		Model:   cfg.Model,
+		APIKey:  key,
+		BaseURL: baseURL,
+	})
+}
+
+// openaiFactory 构造一个原生 OpenAI ChatModel。
+func openaiFactory(ctx context.Context, cfg Config) (model.ToolCallingChatModel, error) {
+	key, err := apiKey(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("openai: %w", err)
+	}
+	return openai.NewChatModel(ctx, &openai.ChatModelConfig{
+		Model:   cfg.Model,
+		APIKey:  key,
+		BaseURL: cfg.BaseURL, // 空字符串时使用 openai 官方默认地址
+	})
+}
+
+// moonshotFactory 构造一个指向 Moonshot（Kimi）的 ChatModel，同样兼容 OpenAI
+// 协议。Kimi 支持在消息里传 file:// / http(s):// 形式的文件 URL part，这里沿用
+// openai.ChatModel 原样透传，不做额外处理。
+func moonshotFactory(ctx context.Context, cfg Config) (model.ToolCallingChatModel, error) {
+	key, err := apiKey(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("moonshot: %w", err)
+	}
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.moonshot.cn/v1"
+	}
+	return openai.NewChatModel(ctx, &openai.ChatModelConfig{
+		Model:   cfg.Model,
+		APIKey:  key,
+		BaseURL: baseURL,
+	})
+}
+
+// skylarkFactory 构造一个基于火山引擎 Skylark（maas）的 ChatModel。
+func skylarkFactory(ctx context.Context, cfg Config) (model.ToolCallingChatModel, error) {
+	key, err := apiKey(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("skylark: %w", err)
+	}
+	return newSkylarkChatModel(cfg.Model, key, cfg.Extra)
+}
+
+// geminiFactory 构造一个基于 Google Gemini 的 ChatModel。
+func geminiFactory(ctx context.Context, cfg Config) (model.ToolCallingChatModel, error) {
+	key, err := apiKey(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("gemini: %w", err)
+	}
+	return newGeminiChatModel(ctx, cfg.Model, key)
+}
@@ -0,0 +1,75 @@
+// Package registry 让 ChatModel 的创建可以通过配置文件切换，而不是像每个 demo
+// 那样把 openai.NewChatModel 和 MiniMax 的 BaseURL 写死在 main() 里。
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/components/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Config 对应 model.yaml / model.json 里的一份配置。
+type Config struct {
+	Provider   string         `json:"provider" yaml:"provider"`
+	Model      string         `json:"model" yaml:"model"`
+	APIKeyEnv  string         `json:"api_key_env" yaml:"api_key_env"`
+	BaseURL    string         `json:"base_url" yaml:"base_url"`
+	Extra      map[string]any `json:"extra" yaml:"extra"`
+}
+
+// Factory 根据 Config 构造一个 ChatModel。
+type Factory func(ctx context.Context, cfg Config) (model.ToolCallingChatModel, error)
+
+var (
+	mu        sync.RWMutex
+	factories = make(map[string]Factory)
+)
+
+// Register 注册一个 provider 的 Factory，provider 名称大小写不敏感。
+// 重复注册同名 provider 会覆盖之前的实现，方便用户替换内置 provider。
+func Register(provider string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	factories[strings.ToLower(provider)] = factory
+}
+
+// NewFromConfig 读取 path 指向的 YAML 或 JSON 配置文件（按扩展名判断格式，
+// .yaml/.yml 走 YAML，否则按 JSON 解析），并用其 provider 字段对应的 Factory
+// 构造 ChatModel。
+func NewFromConfig(ctx context.Context, path string) (model.ToolCallingChatModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("registry: read config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("registry: parse yaml config %s: %w", path, err)
+		}
+	} else {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("registry: parse json config %s: %w", path, err)
+		}
+	}
+
+	return New(ctx, cfg)
+}
+
+// New 根据一份已经解析好的 Config 构造 ChatModel。
+func New(ctx context.Context, cfg Config) (model.ToolCallingChatModel, error) {
+	mu.RLock()
+	factory, ok := factories[strings.ToLower(cfg.Provider)]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("registry: unknown provider %q", cfg.Provider)
+	}
+	return factory(ctx, cfg)
+}
@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/volcengine/volc-sdk-golang/service/maas"
+	"github.com/volcengine/volc-sdk-golang/service/maas/models/api"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	defaultSkylarkHost   = "maas-api.ml-platform-cn-beijing.volces.com"
+	defaultSkylarkRegion = "cn-beijing"
+)
+
+// skylarkChatModel 适配火山引擎 Skylark（maas SDK），把它包装成 eino 的
+// model.ToolCallingChatModel，finish reason 通过 NormalizeFinishReason("skylark", ...)
+// 归一化到 ResponseMeta。
+type skylarkChatModel struct {
+	client *maas.MaaS
+	model  string
+	tools  []*schema.ToolInfo
+}
+
+func newSkylarkChatModel(modelName, apiKey string, extra map[string]any) (model.ToolCallingChatModel, error) {
+	if modelName == "" {
+		return nil, fmt.Errorf("skylark: model is required")
+	}
+	host := defaultSkylarkHost
+	if h, ok := extra["host"].(string); ok && h != "" {
+		host = h
+	}
+	region := defaultSkylarkRegion
+	if r, ok := extra["region"].(string); ok && r != "" {
+		region = r
+	}
+	client := maas.NewInstance(host, region)
+	client.SetAccessKey(apiKey)
+	if secret, ok := extra["secret_key"].(string); ok {
+		client.SetSecretKey(secret)
+	}
+	return &skylarkChatModel{client: client, model: modelName}, nil
+}
+
+func (m *skylarkChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return &skylarkChatModel{client: m.client, model: m.model, tools: tools}, nil
+}
+
+func (m *skylarkChatModel) Generate(ctx context.Context, messages []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	req, err := skylarkChatRequest(m.model, messages, m.tools)
+	if err != nil {
+		return nil, fmt.Errorf("skylark: %w", err)
+	}
+	resp, _, err := m.client.ChatWithCtx(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("skylark: chat: %w", err)
+	}
+	return skylarkToMessage(resp), nil
+}
+
+func (m *skylarkChatModel) Stream(ctx context.Context, messages []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	req, err := skylarkChatRequest(m.model, messages, m.tools)
+	if err != nil {
+		return nil, fmt.Errorf("skylark: %w", err)
+	}
+	stream, err := m.client.StreamChatWithCtx(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("skylark: stream chat: %w", err)
+	}
+
+	sr, sw := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer sw.Close()
+		for chunk := range stream {
+			if chunk.Error != nil {
+				sw.Send(nil, fmt.Errorf("skylark: %s", chunk.Error.Message))
+				return
+			}
+			sw.Send(skylarkToMessage(chunk), nil)
+		}
+	}()
+	return sr, nil
+}
+
+func skylarkChatRequest(modelName string, messages []*schema.Message, tools []*schema.ToolInfo) (*api.ChatReq, error) {
+	req := &api.ChatReq{Model: &api.Model{Name: modelName}}
+	for _, msg := range messages {
+		req.Messages = append(req.Messages, &api.Message{
+			Role:    string(msg.Role),
+			Content: msg.Content,
+		})
+	}
+	for _, t := range tools {
+		params, err := toolJSONSchema(t)
+		if err != nil {
+			return nil, err
+		}
+		sc, err := structpb.NewStruct(params)
+		if err != nil {
+			return nil, fmt.Errorf("tool %s: params to struct: %w", t.Name, err)
+		}
+		req.Functions = append(req.Functions, &api.Function{
+			Name:        t.Name,
+			Description: t.Desc,
+			Parameters:  sc,
+		})
+	}
+	return req, nil
+}
+
+func skylarkToMessage(resp *api.ChatResp) *schema.Message {
+	var toolCalls []schema.ToolCall
+	if fc := resp.Choice.Message.FunctionCall; fc != nil {
+		toolCalls = append(toolCalls, schema.ToolCall{
+			ID:   "call_0",
+			Type: "function",
+			Function: schema.FunctionCall{
+				Name:      fc.Name,
+				Arguments: fc.Arguments,
+			},
+		})
+	}
+
+	msg := schema.AssistantMessage(resp.Choice.Message.Content, toolCalls)
+	msg.ResponseMeta = &schema.ResponseMeta{
+		FinishReason: string(NormalizeFinishReason("skylark", resp.Choice.FinishReason)),
+	}
+	return msg
+}
@@ -0,0 +1,33 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// toolJSONSchema 把 schema.ToolInfo 的 ParamsOneOf 转成通用的 JSON Schema
+// （map[string]any），以便各 provider 按自己 SDK 的格式继续转换（maas 再转成
+// structpb.Struct，gemini 再转成 genai.Schema）。没有参数的工具返回一个空 object。
+func toolJSONSchema(t *schema.ToolInfo) (map[string]any, error) {
+	if t.ParamsOneOf == nil {
+		return map[string]any{"type": "object", "properties": map[string]any{}}, nil
+	}
+
+	sc, err := t.ParamsOneOf.ToJSONSchema()
+	if err != nil {
+		return nil, fmt.Errorf("tool %s: params to json schema: %w", t.Name, err)
+	}
+
+	data, err := json.Marshal(sc)
+	if err != nil {
+		return nil, fmt.Errorf("tool %s: marshal json schema: %w", t.Name, err)
+	}
+
+	var params map[string]any
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("tool %s: unmarshal json schema: %w", t.Name, err)
+	}
+	return params, nil
+}
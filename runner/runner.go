@@ -0,0 +1,139 @@
+// Package runner 把编译好的 Eino Chain/Agent 包装成可以按 cron 表达式周期
+// 执行的定时任务，用于把 demo 改造成"每日天气播报"、"每周摘要"之类的机器人，
+// 而不需要额外的胶水代码。
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+	"github.com/robfig/cron/v3"
+)
+
+// Agent 是已经编译好的、接受消息列表并返回消息列表的 Runnable，
+// 对应 `chain.Compile(ctx)` 或 react.Agent 包装后的产物。
+type Agent = compose.Runnable[[]*schema.Message, []*schema.Message]
+
+// Job 描述一个周期性任务。
+type Job struct {
+	Name  string
+	Cron  string // 标准 5 段 cron 表达式
+	Agent Agent
+
+	InputBuilder func(ctx context.Context) ([]*schema.Message, error)
+	OnResult     func(ctx context.Context, resp []*schema.Message) error
+
+	// MaxRetries 是单次运行失败后的最大重试次数，默认 3。
+	MaxRetries int
+	// RetryBaseDelay 是重试的初始退避时间，每次重试翻倍，默认 2s。
+	RetryBaseDelay time.Duration
+}
+
+// Scheduler 包装 robfig/cron，管理一组 Job 的周期执行、重试与优雅停止。
+type Scheduler struct {
+	cron    *cron.Cron
+	entries map[string]cron.EntryID
+}
+
+// NewScheduler 创建一个空的 Scheduler。
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		cron:    cron.New(),
+		entries: make(map[string]cron.EntryID),
+	}
+}
+
+// Register 将 job 加入调度，返回非 nil error 表示 cron 表达式非法或任务名冲突。
+func (s *Scheduler) Register(job Job) error {
+	if _, exists := s.entries[job.Name]; exists {
+		return fmt.Errorf("runner: job %q already registered", job.Name)
+	}
+	if job.MaxRetries <= 0 {
+		job.MaxRetries = 3
+	}
+	if job.RetryBaseDelay <= 0 {
+		job.RetryBaseDelay = 2 * time.Second
+	}
+
+	entryID, err := s.cron.AddFunc(job.Cron, func() {
+		s.runOnce(job)
+	})
+	if err != nil {
+		return fmt.Errorf("runner: invalid cron expression for job %q: %w", job.Name, err)
+	}
+	s.entries[job.Name] = entryID
+	return nil
+}
+
+func (s *Scheduler) runOnce(job Job) {
+	ctx := context.Background()
+
+	var lastErr error
+	delay := job.RetryBaseDelay
+	for attempt := 0; attempt <= job.MaxRetries; attempt++ {
+		if attempt > 0 {
+			log.Printf("[runner] job %q retry %d/%d after %v (previous error: %v)", job.Name, attempt, job.MaxRetries, delay, lastErr)
+			time.Sleep(delay)
+			delay *= 2
+		}
+
+		if err := s.execute(ctx, job); err != nil {
+			lastErr = err
+			continue
+		}
+		lastErr = nil
+		break
+	}
+
+	if lastErr != nil {
+		log.Printf("[runner] job %q failed after %d attempts: %v", job.Name, job.MaxRetries+1, lastErr)
+	}
+
+	if entryID, ok := s.entries[job.Name]; ok {
+		next := s.cron.Entry(entryID).Next
+		log.Printf("[runner] job %q done, next run in %s", job.Name, time.Until(next).Round(time.Second))
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, job Job) error {
+	input := []*schema.Message{}
+	if job.InputBuilder != nil {
+		built, err := job.InputBuilder(ctx)
+		if err != nil {
+			return fmt.Errorf("build input: %w", err)
+		}
+		input = built
+	}
+
+	resp, err := job.Agent.Invoke(ctx, input)
+	if err != nil {
+		return fmt.Errorf("invoke agent: %w", err)
+	}
+
+	if job.OnResult != nil {
+		if err := job.OnResult(ctx, resp); err != nil {
+			return fmt.Errorf("on result: %w", err)
+		}
+	}
+	return nil
+}
+
+// Start 启动调度循环（非阻塞，内部起一个 goroutine）。
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop 停止接收新的触发，并等待已经在运行的任务结束，或 ctx 超时。
+func (s *Scheduler) Stop(ctx context.Context) error {
+	stopCtx := s.cron.Stop()
+	select {
+	case <-stopCtx.Done():
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("runner: graceful shutdown timed out: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// WeChatWorkSink 返回一个 OnResult，把 Job 的最终响应拼成纯文本消息推送到
+// 企业微信群机器人 webhook（https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=...）。
+func WeChatWorkSink(webhookURL string) func(ctx context.Context, resp []*schema.Message) error {
+	return func(ctx context.Context, resp []*schema.Message) error {
+		return postWebhook(ctx, webhookURL, map[string]any{
+			"msgtype": "text",
+			"text": map[string]string{
+				"content": joinContent(resp),
+			},
+		})
+	}
+}
+
+// DingTalkSink 返回一个 OnResult，把 Job 的最终响应推送到钉钉群机器人 webhook
+// （https://oapi.dingtalk.com/robot/send?access_token=...）。
+func DingTalkSink(webhookURL string) func(ctx context.Context, resp []*schema.Message) error {
+	return func(ctx context.Context, resp []*schema.Message) error {
+		return postWebhook(ctx, webhookURL, map[string]any{
+			"msgtype": "text",
+			"text": map[string]string{
+				"content": joinContent(resp),
+			},
+		})
+	}
+}
+
+func joinContent(resp []*schema.Message) string {
+	parts := make([]string, 0, len(resp))
+	for _, msg := range resp {
+		if msg.Content != "" {
+			parts = append(parts, msg.Content)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func postWebhook(ctx context.Context, url string, payload map[string]any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -1,60 +1,38 @@
 package main
 
 import (
+	"bufio"
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/cloudwego/eino/components/prompt"
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/compose"
 	"github.com/cloudwego/eino/schema"
-	"github.com/cloudwego/eino-ext/components/model/openai"
+
+	"github.com/MeloGum/eino-minimax-demo/model/registry"
+	"github.com/MeloGum/eino-minimax-demo/tools/schemagen"
+	"github.com/MeloGum/eino-minimax-demo/toolloop"
 )
 
 // ============ Tool 定义 ============
 
-// CalculatorParams 计算器参数
+// CalculatorParams 计算器参数。tag 里的 jsonschema 描述由 schemagen 反射读取，
+// 不再需要手写 schema.NewParamsOneOfByParams。
 type CalculatorParams struct {
-	A        float64  `json:"a" jsonschema:"description=第一个数字"`
-	B        float64  `json:"b" jsonschema:"description=第二个数字"`
-	Operator string  `json:"operator" jsonschema:"description=运算符: add, sub, mul, div"`
+	A        float64 `json:"a" jsonschema:"description=第一个数字,required"`
+	B        float64 `json:"b" jsonschema:"description=第二个数字,required"`
+	Operator string  `json:"operator" jsonschema:"description=运算符：add（加）、sub（减）、mul（乘）、div（除）,required"`
 }
 
-// Calculator 计算器工具
-type Calculator struct{}
-
-func (c *Calculator) Info(ctx context.Context) (*schema.ToolInfo, error) {
-	return &schema.ToolInfo{
-		Name:        "calculator",
-		Description: "执行基本数学计算（加、减、乘、除）。例如：计算 10 + 5，计算 100 * 0.5",
-		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
-			"a": {
-				Desc:     "第一个数字",
-				Type:     schema.Float,
-				Required: true,
-			},
-			"b": {
-				Desc:     "第二个数字",
-				Type:     schema.Float,
-				Required: true,
-			},
-			"operator": {
-				Desc:     "运算符：add（加）、sub（减）、mul（乘）、div（除）",
-				Type:     schema.String,
-				Required: true,
-			},
-		}),
-	}, nil
+// CalculatorResult 计算器结果
+type CalculatorResult struct {
+	Result float64 `json:"result"`
 }
 
-func (c *Calculator) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
-	var params CalculatorParams
-	if err := json.Unmarshal([]byte(argumentsInJSON), &params); err != nil {
-		return fmt.Sprintf(`{"error": "参数解析失败: %v"}`, err), nil
-	}
-
+func calculate(ctx context.Context, params CalculatorParams) (CalculatorResult, error) {
 	var result float64
 	switch params.Operator {
 	case "add", "+":
@@ -65,14 +43,14 @@ func (c *Calculator) InvokableRun(ctx context.Context, argumentsInJSON string, o
 		result = params.A * params.B
 	case "div", "/":
 		if params.B == 0 {
-			return `{"error": "除数不能为零"}`, nil
+			return CalculatorResult{}, fmt.Errorf("除数不能为零")
 		}
 		result = params.A / params.B
 	default:
-		return fmt.Sprintf(`{"error": "不支持的运算符: %s"}`, params.Operator), nil
+		return CalculatorResult{}, fmt.Errorf("不支持的运算符: %s", params.Operator)
 	}
 
-	return fmt.Sprintf(`{"result": %.2f}`, result), nil
+	return CalculatorResult{Result: result}, nil
 }
 
 // ============ 主程序 ============
@@ -80,26 +58,15 @@ func (c *Calculator) InvokableRun(ctx context.Context, argumentsInJSON string, o
 func main() {
 	ctx := context.Background()
 
-	// MiniMax API 配置
-	apiKey := os.Getenv("MINIMAX_API_KEY")
-	if apiKey == "" {
-		fmt.Println("Error: MINIMAX_API_KEY not set")
-		os.Exit(1)
-	}
-
-	// 创建 ChatModel
-	chatModel, err := openai.NewChatModel(ctx, &openai.ChatModelConfig{
-		Model:   "MiniMax-M2.1",
-		APIKey:  apiKey,
-		BaseURL: "https://api.minimaxi.com/v1",
-	})
+	// 创建 ChatModel：provider/model/API key 环境变量都来自 model.yaml
+	chatModel, err := registry.NewFromConfig(ctx, "model.yaml")
 	if err != nil {
 		fmt.Printf("Failed to create chat model: %v\n", err)
 		os.Exit(1)
 	}
 
 	// 创建 Tool
-	calcTool := &Calculator{}
+	calcTool := schemagen.NewInvokableTool("calculator", "执行基本数学计算（加、减、乘、除）。例如：计算 10 + 5，计算 100 * 0.5", calculate)
 	calcInfo, err := calcTool.Info(ctx)
 	if err != nil {
 		fmt.Printf("Failed to get tool info: %v\n", err)
@@ -147,25 +114,32 @@ func main() {
 	}
 	fmt.Printf("ChatModel 响应:\n%s\n\n", result.Content)
 
-	// ============ Step 2: 使用 ToolsNode 调用 Tool ============
-	fmt.Println("=== Step 2: ChatModel + ToolsNode ===")
-
-	// 构建 Chain: ChatModel -> ToolsNode
-	chain := compose.NewChain[[]*schema.Message, []*schema.Message]()
-	chain.AppendChatModel(chatModel, compose.WithNodeName("chat_model")).
-		AppendToolsNode(toolsNode, compose.WithNodeName("tools"))
-
-	// 编译 Chain
-	agent, err := chain.Compile(ctx)
-	if err != nil {
-		fmt.Printf("Failed to compile chain: %v\n", err)
-		os.Exit(1)
-	}
+	// ============ Step 2: ChatModel + ToolsNode 自动回环 ============
+	fmt.Println("=== Step 2: ChatModel + ToolsNode（带 tool-call 回环） ===")
+
+	// 一次性的 Chain（ChatModel -> ToolsNode）只能跑一轮：ToolsNode 的结果不会
+	// 被喂回模型，多步工具调用永远无法收尾。这里改用 toolloop.ToolLoop，
+	// 它会在模型继续产生 tool_calls 时自动把工具结果追加回历史再次调用模型，
+	// 并在真正执行工具前询问用户是否确认。
+	loop := toolloop.NewToolLoop(toolloop.Config{
+		ChatModel: chatModel,
+		ToolsNode: toolsNode,
+		MaxSteps:  5,
+		ConfirmFunc: func(ctx context.Context, toolCalls []schema.ToolCall) ([]bool, error) {
+			reader := bufio.NewReader(os.Stdin)
+			allowed := make([]bool, len(toolCalls))
+			for i, tc := range toolCalls {
+				fmt.Printf("即将调用工具 %s，参数: %s\n是否执行？[Y/n] ", tc.Function.Name, tc.Function.Arguments)
+				line, _ := reader.ReadString('\n')
+				allowed[i] = strings.TrimSpace(strings.ToLower(line)) != "n"
+			}
+			return allowed, nil
+		},
+	})
 
-	// 运行 Agent
-	resp, err := agent.Invoke(ctx, messages)
+	resp, err := loop.Invoke(ctx, messages)
 	if err != nil {
-		fmt.Printf("Failed to invoke agent: %v\n", err)
+		fmt.Printf("Failed to run tool loop: %v\n", err)
 		os.Exit(1)
 	}
 
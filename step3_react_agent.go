@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"time"
@@ -11,92 +10,66 @@ import (
 	"github.com/cloudwego/eino/compose"
 	"github.com/cloudwego/eino/flow/agent/react"
 	"github.com/cloudwego/eino/schema"
-	"github.com/cloudwego/eino-ext/components/model/openai"
+
+	"github.com/MeloGum/eino-minimax-demo/model/registry"
+	"github.com/MeloGum/eino-minimax-demo/tools/schemagen"
 )
 
 // ============ Tools 定义 ============
 
 // WeatherParams 查询天气参数
 type WeatherParams struct {
-	City    string `json:"city" jsonschema:"description=城市名称，如北京、上海"`
-	Date    string `json:"date" jsonschema:"description=日期，格式 YYYY-MM-DD"`
+	City string `json:"city" jsonschema:"description=城市名称（中文或英文）,required"`
+	Date string `json:"date" jsonschema:"description=日期，格式 YYYY-MM-DD,required"`
 }
 
-// WeatherTool 天气查询工具
-type WeatherTool struct{}
-
-func (w *WeatherTool) Info(ctx context.Context) (*schema.ToolInfo, error) {
-	return &schema.ToolInfo{
-		Name:        "weather",
-		Description: "查询指定城市和日期的天气情况。使用前请确认城市名称和日期。",
-		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
-			"city": {
-				Desc:     "城市名称（中文或英文）",
-				Type:     schema.String,
-				Required: true,
-			},
-			"date": {
-				Desc:     "日期，格式 YYYY-MM-DD",
-				Type:     schema.String,
-				Required: true,
-			},
-		}),
-	}, nil
+// WeatherResult 查询天气结果
+type WeatherResult struct {
+	City    string `json:"city"`
+	Date    string `json:"date"`
+	Weather string `json:"weather"`
 }
 
-func (w *WeatherTool) Run() tool.InvokableRun {
-	return func(ctx context.Context, arguments string, opts ...tool.Option) (string, error) {
-		var params WeatherParams
-		if err := json.Unmarshal([]byte(arguments), &params); err != nil {
-			return fmt.Sprintf(`{"error": "参数解析失败: %v"}`, err), nil
-		}
-
-		// Mock 天气数据
-		weatherData := map[string]map[string]string{
-			"北京": {
-				"2026-02-05": "晴，-5°C~5°C",
-				"2026-02-06": "多云，-3°C~7°C",
-			},
-			"上海": {
-				"2026-02-05": "小雨，3°C~10°C",
-				"2026-02-06": "阴，2°C~8°C",
-			},
-			"深圳": {
-				"2026-02-05": "晴，15°C~24°C",
-				"2026-02-06": "多云，16°C~25°C",
-			},
-		}
-
-		cityData, ok := weatherData[params.City]
-		if !ok {
-			return fmt.Sprintf(`{"city": "%s", "weather": "数据未找到"}`, params.City), nil
-		}
+// weatherData 是 Mock 天气数据
+var weatherData = map[string]map[string]string{
+	"北京": {
+		"2026-02-05": "晴，-5°C~5°C",
+		"2026-02-06": "多云，-3°C~7°C",
+	},
+	"上海": {
+		"2026-02-05": "小雨，3°C~10°C",
+		"2026-02-06": "阴，2°C~8°C",
+	},
+	"深圳": {
+		"2026-02-05": "晴，15°C~24°C",
+		"2026-02-06": "多云，16°C~25°C",
+	},
+}
 
-		weather, ok := cityData[params.Date]
-		if !ok {
-			return fmt.Sprintf(`{"city": "%s", "date": "%s", "weather": "数据未找到"}`, params.City, params.Date), nil
-		}
+func queryWeather(ctx context.Context, params WeatherParams) (WeatherResult, error) {
+	cityData, ok := weatherData[params.City]
+	if !ok {
+		return WeatherResult{City: params.City, Weather: "数据未找到"}, nil
+	}
 
-		return fmt.Sprintf(`{"city": "%s", "date": "%s", "weather": "%s"}`, params.City, params.Date, weather), nil
+	weather, ok := cityData[params.Date]
+	if !ok {
+		return WeatherResult{City: params.City, Date: params.Date, Weather: "数据未找到"}, nil
 	}
+
+	return WeatherResult{City: params.City, Date: params.Date, Weather: weather}, nil
 }
 
-// TimeTool 获取当前时间
-type TimeTool struct{}
+// TimeParams get_current_time 工具无需任何参数
+type TimeParams struct{}
 
-func (t *TimeTool) Info(ctx context.Context) (*schema.ToolInfo, error) {
-	return &schema.ToolInfo{
-		Name:        "get_current_time",
-		Description: "获取当前时间。用于回答用户关于当前时间的问题。",
-		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{}),
-	}, nil
+// TimeResult 当前时间结果
+type TimeResult struct {
+	CurrentTime string `json:"current_time"`
 }
 
-func (t *TimeTool) Run() tool.InvokableRun {
-	return func(ctx context.Context, arguments string, opts ...tool.Option) (string, error) {
-		now := time.Now().Format("2006-01-02 15:04:05")
-		return fmt.Sprintf(`{"current_time": "%s"}`, now), nil
-	}
+func currentTime(ctx context.Context, _ TimeParams) (TimeResult, error) {
+	return TimeResult{CurrentTime: time.Now().Format("2006-01-02 15:04:05")}, nil
 }
 
 // ============ 主程序 ============
@@ -104,27 +77,16 @@ func (t *TimeTool) Run() tool.InvokableRun {
 func main() {
 	ctx := context.Background()
 
-	// MiniMax API 配置
-	apiKey := os.Getenv("MINIMAX_API_KEY")
-	if apiKey == "" {
-		fmt.Println("Error: MINIMAX_API_KEY not set")
-		os.Exit(1)
-	}
-
-	// 创建 ChatModel
-	chatModel, err := openai.NewChatModel(ctx, &openai.ChatModelConfig{
-		Model:   "MiniMax-M2.1",
-		APIKey:  apiKey,
-		BaseURL: "https://api.minimaxi.com/v1",
-	})
+	// 创建 ChatModel：provider/model/API key 环境变量都来自 model.yaml
+	chatModel, err := registry.NewFromConfig(ctx, "model.yaml")
 	if err != nil {
 		fmt.Printf("Failed to create chat model: %v\n", err)
 		os.Exit(1)
 	}
 
 	// 创建 Tools
-	weatherTool := &WeatherTool{}
-	timeTool := &TimeTool{}
+	weatherTool := schemagen.NewInvokableTool("weather", "查询指定城市和日期的天气情况。使用前请确认城市名称和日期。", queryWeather)
+	timeTool := schemagen.NewInvokableTool("get_current_time", "获取当前时间。用于回答用户关于当前时间的问题。", currentTime)
 
 	// 配置 Tools
 	toolsConfig := compose.ToolsNodeConfig{
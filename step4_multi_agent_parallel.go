@@ -5,135 +5,72 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"sync"
 	"time"
 
 	"github.com/cloudwego/eino/components/prompt"
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/compose"
-	"github.com/cloudwego/eino/flow/agent/react"
 	"github.com/cloudwego/eino/schema"
-	"github.com/cloudwego/eino-ext/components/model/openai"
+
+	"github.com/MeloGum/eino-minimax-demo/agents"
+	"github.com/MeloGum/eino-minimax-demo/model/registry"
+	"github.com/MeloGum/eino-minimax-demo/tools/schemagen"
 )
 
-// ============ Agent 报告结构 ============
+// ============ Tools ============
 
-type AgentReport struct {
-	AgentName string    `json:"agent_name"`
-	Task      string    `json:"task"`
-	Status    string    `json:"status"` // "in_progress", "completed", "failed"
-	Result    string    `json:"result"`
-	Duration  float64   `json:"duration_ms"`
-	Timestamp time.Time `json:"timestamp"`
+// ParallelTaskItem 是 execute_parallel_tasks 入参里的一条任务
+type ParallelTaskItem struct {
+	Name        string `json:"name" jsonschema:"description=任务名称,required"`
+	AgentType   string `json:"agent_type" jsonschema:"description=专业Agent类型：architect/backend_dev/frontend_dev/test_dev/devops,required"`
+	Description string `json:"description" jsonschema:"description=任务描述,required"`
 }
 
-type TaskResult struct {
-	Task     string      `json:"task"`
-	Status   string      `json:"status"`
-	Reports  []AgentReport `json:"reports"`
-	Summary  string     `json:"summary"`
+// ParallelTaskParams execute_parallel_tasks 工具参数
+type ParallelTaskParams struct {
+	Tasks []ParallelTaskItem `json:"tasks" jsonschema:"description=任务列表，每个任务包含 name、agent_type、description,required"`
 }
 
-// ============ Tools ============
-
-// ParallelTaskTool - 并行任务执行工具
-type ParallelTaskTool struct{}
-
-func (t *ParallelTaskTool) Info(ctx context.Context) (*schema.ToolInfo, error) {
-	return &schema.ToolInfo{
-		Name:        "execute_parallel_tasks",
-		Description: "并行执行多个任务，每个任务由不同的专业Agent处理。用于同时进行设计、编码、测试等并行工作。输入为JSON数组格式的任务列表。",
-		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
-			"tasks": {
-				Desc:     "任务列表，JSON数组格式，每个任务包含 name, agent_type, description",
-				Type:     schema.String,
-				Required: true,
-			},
-		}),
-	}, nil
+// newParallelTaskTool 创建一个并行任务工具，委派给 coordinator 驱动真实的子 Agent。
+func newParallelTaskTool(coordinator *agents.Coordinator) tool.InvokableTool {
+	return schemagen.NewInvokableTool(
+		"execute_parallel_tasks",
+		"并行执行多个任务，每个任务由不同的专业Agent处理。用于同时进行设计、编码、测试等并行工作。",
+		func(ctx context.Context, params ParallelTaskParams) (*agents.TaskResult, error) {
+			tasks := make([]agents.Task, 0, len(params.Tasks))
+			for _, item := range params.Tasks {
+				tasks = append(tasks, agents.Task{
+					Name:        item.Name,
+					AgentType:   item.AgentType,
+					Description: item.Description,
+				})
+			}
+			return coordinator.Run(ctx, tasks)
+		},
+	)
 }
 
-func (t *ParallelTaskTool) Run() tool.InvokableRun {
-	return func(ctx context.Context, arguments string, opts ...tool.Option) (string, error) {
-		var tasks []map[string]string
-		if err := json.Unmarshal([]byte(arguments), &tasks); err != nil {
-			return fmt.Sprintf(`{"error": "参数解析失败: %v"}`, err), nil
-		}
-
-		// 模拟并行执行（实际项目中会委派给真实Agent）
-		var wg sync.WaitGroup
-		results := make(chan AgentReport, len(tasks))
-		
-		startTime := time.Now()
-
-		for _, task := range tasks {
-			wg.Add(1)
-			go func(t map[string]string) {
-				defer wg.Done()
-				time.Sleep(time.Duration(500+time.Now().UnixNano()%1000) * time.Millisecond) // 模拟耗时
-				results <- AgentReport{
-					AgentName: t["agent_type"],
-					Task:      t["name"],
-					Status:    "completed",
-					Result:    fmt.Sprintf("✅ %s 已完成", t["name"]),
-					Duration:  float64(time.Since(startTime).Milliseconds()),
-					Timestamp: time.Now(),
-				}
-			}(task)
-		}
-
-		wg.Wait()
-		close(results)
-
-		var reports []AgentReport
-		for r := range results {
-			reports = append(reports, r)
-		}
-
-		summary := fmt.Sprintf("并行任务完成！共 %d 个任务，%d 个成功", len(tasks), len(reports))
-		
-		result := TaskResult{
-			Task:    "并行开发任务",
-			Status:  "completed",
-			Reports: reports,
-			Summary: summary,
-		}
-		
-		data, _ := json.MarshalIndent(result, "", "  ")
-		return string(data), nil
-	}
+// ReportParams generate_report 工具参数
+type ReportParams struct {
+	TaskName    string `json:"task_name" jsonschema:"description=任务名称,required"`
+	WorkSummary string `json:"work_summary" jsonschema:"description=工作摘要,required"`
 }
 
-// ReportTool - 报告生成工具
+// ReportTool - 报告生成工具。输出是给人看的 Markdown 文本而非结构化 JSON，
+// 所以只用 schemagen.ToolInfoFromStruct 生成 Info，InvokableRun 仍手写。
 type ReportTool struct{}
 
 func (t *ReportTool) Info(ctx context.Context) (*schema.ToolInfo, error) {
-	return &schema.ToolInfo{
-		Name:        "generate_report",
-		Description: "生成任务执行报告，汇总各Agent的工作成果。包含任务列表、完成状态、耗时统计和最终总结。",
-		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
-			"task_name": {
-				Desc:     "任务名称",
-				Type:     schema.String,
-				Required: true,
-			},
-			"work_summary": {
-				Desc:     "工作摘要",
-				Type:     schema.String,
-				Required: true,
-			},
-		}),
-	}, nil
+	return schemagen.ToolInfoFromStruct[ReportParams]("generate_report", "生成任务执行报告，汇总各Agent的工作成果。包含任务列表、完成状态、耗时统计和最终总结。")
 }
 
-func (t *ReportTool) Run() tool.InvokableRun {
-	return func(ctx context.Context, arguments string, opts ...tool.Option) (string, error) {
-		var params map[string]string
-		if err := json.Unmarshal([]byte(arguments), &params); err != nil {
-			return fmt.Sprintf(`{"error": "参数解析失败: %v"}`, err), nil
-		}
+func (t *ReportTool) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
+	var params ReportParams
+	if err := json.Unmarshal([]byte(argumentsInJSON), &params); err != nil {
+		return fmt.Sprintf(`{"error": "参数解析失败: %v"}`, err), nil
+	}
 
-		report := fmt.Sprintf(`📋 任务报告: %s
+	report := fmt.Sprintf(`📋 任务报告: %s
 
 📝 工作摘要: %s
 
@@ -141,10 +78,9 @@ func (t *ReportTool) Run() tool.InvokableRun {
 ⏱️ 时间: %s
 
 🎯 总结: 所有任务已成功完成！
-`, params["task_name"], params["work_summary"], time.Now().Format("2006-01-02 15:04:05"))
+`, params.TaskName, params.WorkSummary, time.Now().Format("2006-01-02 15:04:05"))
 
-		return report, nil
-	}
+	return report, nil
 }
 
 // ============ 主程序 ============
@@ -152,26 +88,19 @@ func (t *ReportTool) Run() tool.InvokableRun {
 func main() {
 	ctx := context.Background()
 
-	// MiniMax API 配置
-	apiKey := os.Getenv("MINIMAX_API_KEY")
-	if apiKey == "" {
-		fmt.Println("Error: MINIMAX_API_KEY not set")
-		os.Exit(1)
-	}
-
-	// 创建 ChatModel
-	chatModel, err := openai.NewChatModel(ctx, &openai.ChatModelConfig{
-		Model:   "MiniMax-M2.1",
-		APIKey:  apiKey,
-		BaseURL: "https://api.minimaxi.com/v1",
-	})
+	// 创建 ChatModel：provider/model/API key 环境变量都来自 model.yaml
+	chatModel, err := registry.NewFromConfig(ctx, "model.yaml")
 	if err != nil {
 		fmt.Printf("Failed to create chat model: %v\n", err)
 		os.Exit(1)
 	}
 
+	// 创建多 Agent 协调器，内置 architect/backend_dev/frontend_dev/test_dev/devops 五个角色，
+	// 子 Agent 默认 60s 超时，可通过 coordinator.WithSubAgent 覆盖或追加自定义角色。
+	coordinator := agents.NewCoordinatorWithDefaults(ctx, chatModel, 60*time.Second)
+
 	// 创建 Tools
-	parallelTool := &ParallelTaskTool{}
+	parallelTool := newParallelTaskTool(coordinator)
 	reportTool := &ReportTool{}
 
 	parallelInfo, _ := parallelTool.Info(ctx)
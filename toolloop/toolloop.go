@@ -0,0 +1,158 @@
+// Package toolloop 在 ChatModel -> ToolsNode 之上补上自动的多轮 tool-call 回环：
+// 模型返回 tool_calls 后，工具结果会被重新喂回模型，直到模型给出最终回答或
+// 达到 MaxSteps。一次性的 Chain（参见 step2_agent_with_tools.go）只执行一轮，
+// 多步工具调用永远无法收尾，这个包就是用来补上那个环。
+package toolloop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+)
+
+// ConfirmFunc 在执行工具前被调用，返回的 bool 切片与入参 toolCalls 一一对应：
+// true 表示允许执行该 tool call，false 表示跳过（会给模型回一条"用户拒绝执行"的
+// 工具结果消息）。ConfirmFunc 为 nil 时，所有 tool call 都会被直接执行。
+type ConfirmFunc func(ctx context.Context, toolCalls []schema.ToolCall) ([]bool, error)
+
+// Config 配置一个 ToolLoop。
+type Config struct {
+	ChatModel   model.ToolCallingChatModel
+	ToolsNode   *compose.ToolsNode
+	MaxSteps    int // 最多允许的 tool-call 轮数，<= 0 时默认为 5
+	ConfirmFunc ConfirmFunc
+}
+
+// ToolLoop 是 ChatModel 与 ToolsNode 之间的自动回环。
+type ToolLoop struct {
+	cfg Config
+}
+
+// NewToolLoop 根据 cfg 构建一个 ToolLoop。
+func NewToolLoop(cfg Config) *ToolLoop {
+	if cfg.MaxSteps <= 0 {
+		cfg.MaxSteps = 5
+	}
+	return &ToolLoop{cfg: cfg}
+}
+
+// Invoke 运行 ChatModel -> (确认 -> ToolsNode -> ChatModel)* 直到模型不再返回
+// tool_calls，或达到 MaxSteps，返回完整的消息历史（包含每一轮的助手消息与工具结果）。
+func (l *ToolLoop) Invoke(ctx context.Context, messages []*schema.Message) ([]*schema.Message, error) {
+	history := append([]*schema.Message(nil), messages...)
+
+	for step := 0; step <= l.cfg.MaxSteps; step++ {
+		resp, err := l.cfg.ChatModel.Generate(ctx, history)
+		if err != nil {
+			return history, fmt.Errorf("tool loop: generate failed at step %d: %w", step, err)
+		}
+		history = append(history, resp)
+
+		if len(resp.ToolCalls) == 0 {
+			return history, nil
+		}
+		if step == l.cfg.MaxSteps {
+			break
+		}
+
+		toolCalls := resp.ToolCalls
+		allowed := make([]bool, len(toolCalls))
+		for i := range allowed {
+			allowed[i] = true
+		}
+		if l.cfg.ConfirmFunc != nil {
+			allowed, err = l.cfg.ConfirmFunc(ctx, toolCalls)
+			if err != nil {
+				return history, fmt.Errorf("tool loop: confirm failed at step %d: %w", step, err)
+			}
+		}
+
+		toRun := make([]schema.ToolCall, 0, len(toolCalls))
+		for i, tc := range toolCalls {
+			if i < len(allowed) && allowed[i] {
+				toRun = append(toRun, tc)
+			} else {
+				history = append(history, schema.ToolMessage("用户拒绝执行该工具调用", tc.ID))
+			}
+		}
+
+		if len(toRun) > 0 {
+			results, err := l.cfg.ToolsNode.Invoke(ctx, schema.AssistantMessage("", toRun))
+			if err != nil {
+				return history, fmt.Errorf("tool loop: tools node failed at step %d: %w", step, err)
+			}
+			history = append(history, results...)
+		}
+	}
+
+	return history, fmt.Errorf("tool loop: exceeded MaxSteps (%d) without a final answer", l.cfg.MaxSteps)
+}
+
+// Stream 与 Invoke 等价，但最后一步（模型不再产生 tool_calls 时）以流式返回，
+// 便于调用方边生成边展示最终回答；此前各轮工具调用仍然是阻塞执行的。
+//
+// 每一步只调用一次 ChatModel.Stream：用 Copy 复制出的一份去拼出完整消息以判断
+// 是否有 tool_calls，另一份原样保留。判断为最终回答时直接把保留的那份流返回给
+// 调用方，不会为了"看一眼有没有 tool_calls"而让模型重复生成一次答案。
+func (l *ToolLoop) Stream(ctx context.Context, messages []*schema.Message) (*schema.StreamReader[*schema.Message], []*schema.Message, error) {
+	history := append([]*schema.Message(nil), messages...)
+
+	for step := 0; step <= l.cfg.MaxSteps; step++ {
+		stream, err := l.cfg.ChatModel.Stream(ctx, history)
+		if err != nil {
+			return nil, history, fmt.Errorf("tool loop: stream failed at step %d: %w", step, err)
+		}
+
+		copies := stream.Copy(2)
+		resp, err := schema.ConcatMessageStream(copies[0])
+		if err != nil {
+			copies[1].Close()
+			return nil, history, fmt.Errorf("tool loop: concat stream failed at step %d: %w", step, err)
+		}
+
+		if len(resp.ToolCalls) == 0 {
+			history = append(history, resp)
+			return copies[1], history, nil
+		}
+		copies[1].Close()
+
+		history = append(history, resp)
+		if step == l.cfg.MaxSteps {
+			break
+		}
+
+		toolCalls := resp.ToolCalls
+		allowed := make([]bool, len(toolCalls))
+		for i := range allowed {
+			allowed[i] = true
+		}
+		if l.cfg.ConfirmFunc != nil {
+			allowed, err = l.cfg.ConfirmFunc(ctx, toolCalls)
+			if err != nil {
+				return nil, history, fmt.Errorf("tool loop: confirm failed at step %d: %w", step, err)
+			}
+		}
+
+		toRun := make([]schema.ToolCall, 0, len(toolCalls))
+		for i, tc := range toolCalls {
+			if i < len(allowed) && allowed[i] {
+				toRun = append(toRun, tc)
+			} else {
+				history = append(history, schema.ToolMessage("用户拒绝执行该工具调用", tc.ID))
+			}
+		}
+
+		if len(toRun) > 0 {
+			results, err := l.cfg.ToolsNode.Invoke(ctx, schema.AssistantMessage("", toRun))
+			if err != nil {
+				return nil, history, fmt.Errorf("tool loop: tools node failed at step %d: %w", step, err)
+			}
+			history = append(history, results...)
+		}
+	}
+
+	return nil, history, fmt.Errorf("tool loop: exceeded MaxSteps (%d) without a final answer", l.cfg.MaxSteps)
+}
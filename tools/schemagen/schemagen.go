@@ -0,0 +1,343 @@
+// Package schemagen 从带 `json`/`jsonschema`/`eino` tag 的 struct 反射生成
+// schema.ToolInfo 与 tool.InvokableTool，替代每个工具手写
+// schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{...}) 的样板代码。
+package schemagen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+)
+
+// ToolInfoFromStruct 反射 Params 结构体的字段，生成对应的 schema.ToolInfo。
+// 字段名取自 `json` tag，描述/约束取自 `jsonschema` tag（description、enum、
+// minimum、maximum、required），嵌套 struct 与 slice 会被递归处理。
+func ToolInfoFromStruct[Params any](name, description string) (*schema.ToolInfo, error) {
+	var zero Params
+	params, err := paramsFromStruct(reflect.TypeOf(zero))
+	if err != nil {
+		return nil, fmt.Errorf("schemagen: %s: %w", name, err)
+	}
+	return &schema.ToolInfo{
+		Name:        name,
+		Desc:        description,
+		ParamsOneOf: schema.NewParamsOneOfByParams(params),
+	}, nil
+}
+
+// NewInvokableTool 用 fn 构造一个 tool.InvokableTool：Info() 由 ToolInfoFromStruct[Params]
+// 生成，InvokableRun 负责把 JSON 参数反序列化进 Params、调用 fn、再把 Result 序列化回 JSON。
+// fn 返回的 error 会被转成 `{"error": "..."}` 形式的字符串结果而不是 Go error，
+// 和仓库里其它手写工具的约定保持一致。
+func NewInvokableTool[Params any, Result any](name, description string, fn func(ctx context.Context, params Params) (Result, error)) tool.InvokableTool {
+	return &genericTool[Params, Result]{name: name, description: description, fn: fn}
+}
+
+type genericTool[Params any, Result any] struct {
+	name        string
+	description string
+	fn          func(ctx context.Context, params Params) (Result, error)
+}
+
+func (t *genericTool[Params, Result]) Info(ctx context.Context) (*schema.ToolInfo, error) {
+	return ToolInfoFromStruct[Params](t.name, t.description)
+}
+
+func (t *genericTool[Params, Result]) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
+	var params Params
+	if err := json.Unmarshal([]byte(argumentsInJSON), &params); err != nil {
+		return fmt.Sprintf(`{"error": "参数解析失败: %v"}`, err), nil
+	}
+
+	var raw map[string]json.RawMessage
+	_ = json.Unmarshal([]byte(argumentsInJSON), &raw)
+	if err := applyDefaults(reflect.ValueOf(&params).Elem(), raw); err != nil {
+		return fmt.Sprintf(`{"error": "默认值填充失败: %v"}`, err), nil
+	}
+
+	result, err := t.fn(ctx, params)
+	if err != nil {
+		return fmt.Sprintf(`{"error": "%v"}`, err), nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("schemagen: marshal result for %s: %w", t.name, err)
+	}
+	return string(data), nil
+}
+
+// ============ 反射实现 ============
+
+type jsonschemaTag struct {
+	description string
+	enum        []string
+	minimum     *float64
+	maximum     *float64
+	required    bool
+}
+
+func parseJSONSchemaTag(tag string) jsonschemaTag {
+	var info jsonschemaTag
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		key := kv[0]
+		val := ""
+		if len(kv) == 2 {
+			val = kv[1]
+		}
+		switch key {
+		case "description":
+			info.description = val
+		case "enum":
+			info.enum = strings.Split(val, "|")
+		case "minimum":
+			if f, err := strconv.ParseFloat(val, 64); err == nil {
+				info.minimum = &f
+			}
+		case "maximum":
+			if f, err := strconv.ParseFloat(val, 64); err == nil {
+				info.maximum = &f
+			}
+		case "required":
+			info.required = val == "" || val == "true"
+		}
+	}
+	return info
+}
+
+// einoDefault 从 `eino` tag（如 `eino:"default=zh-CN"`）里取出默认值。
+// eino 的 ParameterInfo 没有默认值字段，没法塞进 ToolInfo/schema 里让模型看到，
+// 所以默认值改由 applyDefaults 在 InvokableRun 反序列化之后、调用 fn 之前，
+// 对 JSON 里缺失的字段直接填充。
+func einoDefault(tag string) (string, bool) {
+	for _, part := range strings.Split(tag, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) == 2 && kv[0] == "default" {
+			return kv[1], true
+		}
+	}
+	return "", false
+}
+
+// applyDefaults 遍历 v（必须是 struct）的字段，对 raw 里缺失对应 JSON key 的
+// 字段，用其 `eino:"default=..."` tag 填充默认值；raw 为 nil（argumentsInJSON
+// 不是一个 JSON object）时所有字段都按缺失处理。嵌套 struct 字段会递归处理。
+func applyDefaults(v reflect.Value, raw map[string]json.RawMessage) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // 未导出字段跳过
+			continue
+		}
+
+		name := field.Name
+		if jsonTag := field.Tag.Get("json"); jsonTag != "" {
+			if n := strings.Split(jsonTag, ",")[0]; n != "" {
+				name = n
+			}
+		}
+		if name == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if rawField, present := raw[name]; present {
+			if fv.Kind() == reflect.Struct {
+				var nestedRaw map[string]json.RawMessage
+				_ = json.Unmarshal(rawField, &nestedRaw)
+				if err := applyDefaults(fv, nestedRaw); err != nil {
+					return fmt.Errorf("field %s: %w", field.Name, err)
+				}
+			}
+			continue
+		}
+
+		if def, ok := einoDefault(field.Tag.Get("eino")); ok {
+			if err := setDefaultValue(fv, def); err != nil {
+				return fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			continue
+		}
+
+		if fv.Kind() == reflect.Struct {
+			if err := applyDefaults(fv, nil); err != nil {
+				return fmt.Errorf("field %s: %w", field.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// setDefaultValue 把 `eino:"default=..."` 里的字符串值按字段的 Kind 转换后写入 fv。
+func setDefaultValue(fv reflect.Value, def string) error {
+	if !fv.CanSet() {
+		return nil
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(def)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(def)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(def, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(def, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(def, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	default:
+		return fmt.Errorf("unsupported default value type %s", fv.Kind())
+	}
+	return nil
+}
+
+func dataTypeOf(t reflect.Type) schema.DataType {
+	switch t.Kind() {
+	case reflect.String:
+		return schema.String
+	case reflect.Bool:
+		return schema.Boolean
+	case reflect.Float32, reflect.Float64:
+		return schema.Number
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema.Integer
+	case reflect.Slice, reflect.Array:
+		return schema.Array
+	case reflect.Struct:
+		return schema.Object
+	case reflect.Ptr:
+		return dataTypeOf(t.Elem())
+	default:
+		return schema.String
+	}
+}
+
+func paramsFromStruct(t reflect.Type) (map[string]*schema.ParameterInfo, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s must be a struct", t)
+	}
+
+	params := make(map[string]*schema.ParameterInfo, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // 未导出字段跳过
+			continue
+		}
+
+		name := field.Name
+		if jsonTag := field.Tag.Get("json"); jsonTag != "" {
+			if n := strings.Split(jsonTag, ",")[0]; n != "" {
+				name = n
+			}
+		}
+		if name == "-" {
+			continue
+		}
+
+		tagged := parseJSONSchemaTag(field.Tag.Get("jsonschema"))
+		desc := tagged.description
+		if tagged.minimum != nil || tagged.maximum != nil {
+			desc = appendRange(desc, tagged.minimum, tagged.maximum)
+		}
+
+		pi := &schema.ParameterInfo{
+			Desc:     desc,
+			Type:     dataTypeOf(field.Type),
+			Required: tagged.required,
+			Enum:     tagged.enum,
+		}
+
+		elemType := field.Type
+		for elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		switch elemType.Kind() {
+		case reflect.Struct:
+			nested, err := paramsFromStruct(elemType)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			pi.SubParams = nested
+		case reflect.Slice, reflect.Array:
+			elemInfo, err := elemParamInfo(elemType.Elem())
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			pi.ElemInfo = elemInfo
+		}
+
+		params[name] = pi
+	}
+	return params, nil
+}
+
+func elemParamInfo(elem reflect.Type) (*schema.ParameterInfo, error) {
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.Struct {
+		nested, err := paramsFromStruct(elem)
+		if err != nil {
+			return nil, err
+		}
+		return &schema.ParameterInfo{Type: schema.Object, SubParams: nested}, nil
+	}
+	return &schema.ParameterInfo{Type: dataTypeOf(elem)}, nil
+}
+
+func appendRange(desc string, min, max *float64) string {
+	var b strings.Builder
+	b.WriteString(desc)
+	b.WriteString("（范围：")
+	if min != nil {
+		fmt.Fprintf(&b, ">=%g", *min)
+	}
+	if min != nil && max != nil {
+		b.WriteString(", ")
+	}
+	if max != nil {
+		fmt.Fprintf(&b, "<=%g", *max)
+	}
+	b.WriteString("）")
+	return b.String()
+}